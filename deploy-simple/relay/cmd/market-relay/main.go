@@ -3,16 +3,21 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"iter"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"fiatjaf.com/nostr"
@@ -31,7 +36,7 @@ type config struct {
 	Description     string
 	Contact         string
 	Icon            string
-	PubKey          string
+	PubKey          *nostr.PubKey
 	PublicURL       string
 	ListenAddr      string
 	DataDir         string
@@ -41,6 +46,59 @@ type config struct {
 	SupportedNIPs   []int
 	ReadHeaderMs    time.Duration
 	ShutdownTimeout time.Duration
+	LogLevel        string
+	LogFormat       string
+
+	// src names each setting in messages by the flag or env var it came from.
+	src settingSource
+
+	// loadProblems holds values that could not be parsed; validate reports
+	// them together with the semantic checks.
+	loadProblems []error
+}
+
+// setting is the single definition of a config value. It is keyed by its
+// environment variable in settings, and its command-line flag is derived
+// from that name.
+type setting struct {
+	Group   string
+	Default string
+	Usage   string
+}
+
+var settingGroups = []string{"server", "relay info", "storage", "logging"}
+
+var settings = map[string]setting{
+	"RELAY_LISTEN_ADDR":            {Group: "server", Default: "127.0.0.1:10547", Usage: "host:port the HTTP/websocket server listens on"},
+	"RELAY_PUBLIC_URL":             {Group: "server", Default: "ws://localhost:10547", Usage: "public ws/wss URL of the relay"},
+	"RELAY_MAX_QUERY_LIMIT":        {Group: "server", Default: "500", Usage: "maximum number of events returned per filter"},
+	"RELAY_READ_HEADER_TIMEOUT_MS": {Group: "server", Default: "10000", Usage: "HTTP read header timeout in milliseconds"},
+	"RELAY_SHUTDOWN_TIMEOUT_MS":    {Group: "server", Default: "10000", Usage: "graceful shutdown timeout in milliseconds"},
+	"RELAY_NAME":                   {Group: "relay info", Default: "Plebeian Market Relay", Usage: "NIP-11 relay name"},
+	"RELAY_DESCRIPTION":            {Group: "relay info", Default: "Plebeian Market application relay", Usage: "NIP-11 relay description"},
+	"RELAY_CONTACT":                {Group: "relay info", Usage: "NIP-11 contact"},
+	"RELAY_ICON":                   {Group: "relay info", Usage: "NIP-11 icon URL"},
+	"RELAY_PUBKEY":                 {Group: "relay info", Usage: "NIP-11 operator pubkey (hex)"},
+	"RELAY_SUPPORTED_NIPS":         {Group: "relay info", Default: "1,11,50", Usage: "comma-separated NIPs advertised in NIP-11"},
+	"RELAY_DATA_DIR":               {Group: "storage", Default: "/var/lib/market-relay", Usage: "base data directory"},
+	"RELAY_RAW_DB_DIR":             {Group: "storage", Default: "/var/lib/market-relay/raw", Usage: "BoltDB raw event store directory"},
+	"RELAY_SEARCH_INDEX_DIR":       {Group: "storage", Default: "/var/lib/market-relay/search", Usage: "Bleve search index directory"},
+	"RELAY_LOG_LEVEL":              {Group: "logging", Default: "info", Usage: "log level: debug, info, warn or error"},
+	"RELAY_LOG_FORMAT":             {Group: "logging", Default: "text", Usage: "log format: text or json"},
+}
+
+// settingSource resolves settings in precedence order: an explicitly set
+// flag, then a non-blank environment variable, then the default.
+type settingSource struct {
+	flags  map[string]string
+	getenv func(string) string
+}
+
+// configLoader parses typed settings and records every value that does not
+// parse instead of silently substituting the default.
+type configLoader struct {
+	src      settingSource
+	problems []error
 }
 
 type compositeStore struct {
@@ -49,23 +107,50 @@ type compositeStore struct {
 }
 
 func main() {
-	cfg, err := loadConfig()
-	if err != nil {
-		log.Fatal(err)
+	validateOnly := flag.Bool("validate-only", false, "validate configuration and exit without starting the relay")
+	registerSettingFlags(flag.CommandLine)
+	if err := parseFlags(flag.CommandLine, os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.CommandLine.Usage()
+		os.Exit(2)
+	}
+
+	cfg := loadConfig(newSettingSource(flag.CommandLine, os.Getenv))
+	logger := newLogger(cfg)
+	slog.SetDefault(logger)
+
+	if problems := cfg.validate(); len(problems) > 0 {
+		for _, problem := range problems {
+			slog.Error("invalid configuration", "problem", problem)
+		}
+		os.Exit(1)
+	}
+	if *validateOnly {
+		slog.Info("configuration is valid", "version", version)
+		return
+	}
+
+	if err := createDirs(cfg); err != nil {
+		fatal("prepare data directories", err)
 	}
 
 	store, cleanup, err := openStore(cfg)
 	if err != nil {
-		log.Fatal(err)
+		fatal("open event store", err)
 	}
 	defer cleanup()
 
 	relay := khatru.NewRelay()
+	// khatru only logs websocket failures such as unexpected closes and ping
+	// errors, which are routine client noise; per-connection traffic is
+	// logged at debug below.
+	relay.Log = slog.NewLogLogger(logger.With("component", "khatru").Handler(), slog.LevelWarn)
 	relay.ServiceURL = cfg.PublicURL
 	relay.Info.Name = cfg.Name
 	relay.Info.Description = cfg.Description
 	relay.Info.Contact = cfg.Contact
 	relay.Info.Icon = cfg.Icon
+	relay.Info.PubKey = cfg.PubKey
 	relay.Info.Software = "https://github.com/PlebeianTech/market/tree/master/deploy-simple/relay"
 	relay.Info.Version = version
 	relay.Info.AddSupportedNIPs(cfg.SupportedNIPs)
@@ -73,14 +158,6 @@ func main() {
 		MaxLimit: cfg.MaxQueryLimit,
 	}
 
-	if cfg.PubKey != "" {
-		pubKey, err := nostr.PubKeyFromHex(cfg.PubKey)
-		if err != nil {
-			log.Fatalf("invalid RELAY_PUBKEY: %v", err)
-		}
-		relay.Info.PubKey = &pubKey
-	}
-
 	relay.OnEvent = policies.SeqEvent(
 		policies.ValidateKind,
 		policies.RejectEventsWithBase64Media,
@@ -88,6 +165,9 @@ func main() {
 	)
 	relay.OnRequest = policies.SeqRequest(policies.NoComplexFilters)
 	relay.UseEventstore(store, cfg.MaxQueryLimit)
+	if logger.Enabled(context.Background(), slog.LevelDebug) {
+		logRelayTraffic(relay, logger.With("component", "relay"))
+	}
 
 	router := relay.Router()
 	router.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
@@ -102,9 +182,9 @@ func main() {
 	}
 
 	go func() {
-		log.Printf("market-relay %s listening on %s (%s)", version, cfg.ListenAddr, cfg.PublicURL)
+		slog.Info("market-relay listening", "version", version, "addr", cfg.ListenAddr, "public_url", cfg.PublicURL)
 		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			log.Fatal(err)
+			fatal("relay server failed", err)
 		}
 	}()
 
@@ -116,35 +196,200 @@ func main() {
 	defer cancel()
 
 	if err := server.Shutdown(shutdownCtx); err != nil {
-		log.Printf("relay shutdown failed: %v", err)
+		slog.Error("relay shutdown failed", "error", err)
 	}
 }
 
-func loadConfig() (config, error) {
+func loadConfig(src settingSource) config {
+	l := &configLoader{src: src}
 	cfg := config{
-		Name:            envOr("RELAY_NAME", "Plebeian Market Relay"),
-		Description:     envOr("RELAY_DESCRIPTION", "Plebeian Market application relay"),
-		Contact:         os.Getenv("RELAY_CONTACT"),
-		Icon:            os.Getenv("RELAY_ICON"),
-		PubKey:          os.Getenv("RELAY_PUBKEY"),
-		PublicURL:       envOr("RELAY_PUBLIC_URL", "ws://localhost:10547"),
-		ListenAddr:      envOr("RELAY_LISTEN_ADDR", "127.0.0.1:10547"),
-		DataDir:         envOr("RELAY_DATA_DIR", "/var/lib/market-relay"),
-		SearchIndexDir:  envOr("RELAY_SEARCH_INDEX_DIR", "/var/lib/market-relay/search"),
-		RawEventStore:   envOr("RELAY_RAW_DB_DIR", "/var/lib/market-relay/raw"),
-		MaxQueryLimit:   envOrInt("RELAY_MAX_QUERY_LIMIT", 500),
-		SupportedNIPs:   envOrInts("RELAY_SUPPORTED_NIPS", []int{1, 11, 50}),
-		ReadHeaderMs:    time.Duration(envOrInt("RELAY_READ_HEADER_TIMEOUT_MS", 10000)) * time.Millisecond,
-		ShutdownTimeout: time.Duration(envOrInt("RELAY_SHUTDOWN_TIMEOUT_MS", 10000)) * time.Millisecond,
+		Name:            src.string("RELAY_NAME"),
+		Description:     src.string("RELAY_DESCRIPTION"),
+		Contact:         src.string("RELAY_CONTACT"),
+		Icon:            src.string("RELAY_ICON"),
+		PubKey:          l.pubKey("RELAY_PUBKEY"),
+		PublicURL:       src.string("RELAY_PUBLIC_URL"),
+		ListenAddr:      src.string("RELAY_LISTEN_ADDR"),
+		DataDir:         src.string("RELAY_DATA_DIR"),
+		SearchIndexDir:  src.string("RELAY_SEARCH_INDEX_DIR"),
+		RawEventStore:   src.string("RELAY_RAW_DB_DIR"),
+		MaxQueryLimit:   l.int("RELAY_MAX_QUERY_LIMIT"),
+		SupportedNIPs:   l.ints("RELAY_SUPPORTED_NIPS"),
+		ReadHeaderMs:    time.Duration(l.int("RELAY_READ_HEADER_TIMEOUT_MS")) * time.Millisecond,
+		ShutdownTimeout: time.Duration(l.int("RELAY_SHUTDOWN_TIMEOUT_MS")) * time.Millisecond,
+		LogLevel:        src.string("RELAY_LOG_LEVEL"),
+		LogFormat:       src.string("RELAY_LOG_FORMAT"),
+	}
+	cfg.src = src
+	cfg.loadProblems = l.problems
+	return cfg
+}
+
+// validate checks the whole config up front and returns every problem at
+// once, so a bad deploy fails before the stores are opened or the listener
+// is bound.
+func (cfg config) validate() []error {
+	name := cfg.src.name
+	problems := slices.Clone(cfg.loadProblems)
+
+	if strings.TrimSpace(cfg.Name) == "" {
+		problems = append(problems, fmt.Errorf("%s must not be empty", name("RELAY_NAME")))
+	}
+
+	if publicURL, err := url.Parse(cfg.PublicURL); err != nil {
+		problems = append(problems, fmt.Errorf("%s %q is not a valid URL: %v", name("RELAY_PUBLIC_URL"), cfg.PublicURL, err))
+	} else if publicURL.Scheme != "ws" && publicURL.Scheme != "wss" {
+		problems = append(problems, fmt.Errorf("%s %q must use the ws or wss scheme", name("RELAY_PUBLIC_URL"), cfg.PublicURL))
+	} else if publicURL.Host == "" {
+		problems = append(problems, fmt.Errorf("%s %q is missing a host", name("RELAY_PUBLIC_URL"), cfg.PublicURL))
+	}
+
+	if _, port, err := net.SplitHostPort(cfg.ListenAddr); err != nil {
+		problems = append(problems, fmt.Errorf("%s %q must be host:port: %v", name("RELAY_LISTEN_ADDR"), cfg.ListenAddr, err))
+	} else if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		problems = append(problems, fmt.Errorf("%s %q has an invalid port", name("RELAY_LISTEN_ADDR"), cfg.ListenAddr))
+	}
+
+	if cfg.MaxQueryLimit <= 0 {
+		problems = append(problems, fmt.Errorf("%s must be positive, got %d", name("RELAY_MAX_QUERY_LIMIT"), cfg.MaxQueryLimit))
+	}
+
+	for _, nip := range cfg.SupportedNIPs {
+		if nip <= 0 {
+			problems = append(problems, fmt.Errorf("%s contains invalid NIP %d", name("RELAY_SUPPORTED_NIPS"), nip))
+		}
+	}
+
+	if cfg.ReadHeaderMs <= 0 {
+		problems = append(problems, fmt.Errorf("%s must be positive, got %d", name("RELAY_READ_HEADER_TIMEOUT_MS"), cfg.ReadHeaderMs.Milliseconds()))
+	}
+	if cfg.ShutdownTimeout <= 0 {
+		problems = append(problems, fmt.Errorf("%s must be positive, got %d", name("RELAY_SHUTDOWN_TIMEOUT_MS"), cfg.ShutdownTimeout.Milliseconds()))
+	}
+
+	if _, err := parseLogLevel(cfg.LogLevel); err != nil {
+		problems = append(problems, fmt.Errorf("%s %q must be one of debug, info, warn, error", name("RELAY_LOG_LEVEL"), cfg.LogLevel))
+	}
+	if cfg.LogFormat != "text" && cfg.LogFormat != "json" {
+		problems = append(problems, fmt.Errorf("%s %q must be text or json", name("RELAY_LOG_FORMAT"), cfg.LogFormat))
+	}
+
+	dirs := []struct{ env, dir string }{
+		{"RELAY_DATA_DIR", cfg.DataDir},
+		{"RELAY_RAW_DB_DIR", cfg.RawEventStore},
+		{"RELAY_SEARCH_INDEX_DIR", cfg.SearchIndexDir},
+	}
+	for _, d := range dirs {
+		if strings.TrimSpace(d.dir) == "" {
+			problems = append(problems, fmt.Errorf("%s must not be empty", name(d.env)))
+		}
+	}
+
+	if cfg.SearchIndexDir != "" && filepath.Clean(cfg.SearchIndexDir) == filepath.Clean(cfg.RawEventStore) {
+		problems = append(problems, fmt.Errorf("%s and %s must be different directories", name("RELAY_SEARCH_INDEX_DIR"), name("RELAY_RAW_DB_DIR")))
+	}
+
+	return problems
+}
+
+// newLogger builds the process logger from the config. Invalid values fall
+// back to info/text so validate can still report them through the logger.
+func newLogger(cfg config) *slog.Logger {
+	level, err := parseLogLevel(cfg.LogLevel)
+	if err != nil {
+		level = slog.LevelInfo
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	if cfg.LogFormat == "json" {
+		return slog.New(slog.NewJSONHandler(os.Stderr, opts))
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, opts))
+}
+
+// parseLogLevel accepts exactly the four level names, ignoring case; the
+// offset forms slog itself understands (e.g. "INFO+2") are rejected.
+func parseLogLevel(value string) (slog.Level, error) {
+	switch strings.ToLower(value) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("unknown log level %q", value)
+}
+
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// logRelayTraffic wraps the relay hooks to log connections, requests and
+// events at debug level, keeping the original policy decisions.
+func logRelayTraffic(relay *khatru.Relay, logger *slog.Logger) {
+	remote := func(ctx context.Context) string {
+		if ws := khatru.GetConnection(ctx); ws != nil && ws.Request != nil {
+			return khatru.GetIPFromRequest(ws.Request)
+		}
+		return ""
 	}
 
+	onConnect := relay.OnConnect
+	relay.OnConnect = func(ctx context.Context) {
+		if onConnect != nil {
+			onConnect(ctx)
+		}
+		logger.Debug("client connected", "remote", remote(ctx))
+	}
+
+	onDisconnect := relay.OnDisconnect
+	relay.OnDisconnect = func(ctx context.Context) {
+		if onDisconnect != nil {
+			onDisconnect(ctx)
+		}
+		logger.Debug("client disconnected", "remote", remote(ctx))
+	}
+
+	onRequest := relay.OnRequest
+	relay.OnRequest = func(ctx context.Context, filter nostr.Filter) (bool, string) {
+		reject, msg := false, ""
+		if onRequest != nil {
+			reject, msg = onRequest(ctx, filter)
+		}
+		logger.Debug("request", "remote", remote(ctx), "filter", filter.String(), "rejected", reject, "reason", msg)
+		return reject, msg
+	}
+
+	onEvent := relay.OnEvent
+	relay.OnEvent = func(ctx context.Context, evt nostr.Event) (bool, string) {
+		reject, msg := false, ""
+		if onEvent != nil {
+			reject, msg = onEvent(ctx, evt)
+		}
+		logger.Debug("event", "remote", remote(ctx), "event_id", evt.ID.Hex(), "kind", int(evt.Kind), "pubkey", evt.PubKey.Hex(), "rejected", reject, "reason", msg)
+		return reject, msg
+	}
+
+	onEventSaved := relay.OnEventSaved
+	relay.OnEventSaved = func(ctx context.Context, evt nostr.Event) {
+		if onEventSaved != nil {
+			onEventSaved(ctx, evt)
+		}
+		logger.Debug("event saved", "event_id", evt.ID.Hex(), "kind", int(evt.Kind))
+	}
+}
+
+func createDirs(cfg config) error {
 	for _, dir := range []string{cfg.DataDir, cfg.RawEventStore} {
 		if err := os.MkdirAll(filepath.Clean(dir), 0o755); err != nil {
-			return config{}, fmt.Errorf("create relay dir %s: %w", dir, err)
+			return fmt.Errorf("create relay dir %s: %w", dir, err)
 		}
 	}
-
-	return cfg, nil
+	return nil
 }
 
 func openStore(cfg config) (eventstore.Store, func(), error) {
@@ -186,7 +431,7 @@ func openStore(cfg config) (eventstore.Store, func(), error) {
 func closeMaybe(v any) {
 	if closer, ok := v.(interface{ Close() error }); ok {
 		if err := closer.Close(); err != nil {
-			log.Printf("close failed: %v", err)
+			slog.Error("close failed", "error", err)
 		}
 	}
 }
@@ -274,32 +519,141 @@ func (s *compositeStore) CountEvents(filter nostr.Filter) (uint32, error) {
 	return s.raw.CountEvents(filter)
 }
 
-func envOr(key, fallback string) string {
-	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
-		return value
+func settingFlagName(env string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(env, "RELAY_")), "_", "-")
+}
+
+// registerSettingFlags adds one flag per setting and a help screen grouped by
+// area.
+func registerSettingFlags(fs *flag.FlagSet) {
+	for env, s := range settings {
+		fs.String(settingFlagName(env), s.Default, s.Usage)
+	}
+
+	fs.Usage = func() {
+		out := fs.Output()
+		fmt.Fprintf(out, "Usage: %s [flags]\n\n", filepath.Base(os.Args[0]))
+		fmt.Fprintln(out, "Every flag can also be set through its environment variable; an explicit flag wins.")
+
+		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, group := range settingGroups {
+			fmt.Fprintf(tw, "\n%s:\n", group)
+			for _, env := range settingsInGroup(group) {
+				s := settings[env]
+				fmt.Fprintf(tw, "  --%s\t%s\t%s", settingFlagName(env), env, s.Usage)
+				if s.Default != "" {
+					fmt.Fprintf(tw, " (default %q)", s.Default)
+				}
+				fmt.Fprintln(tw)
+			}
+		}
+		fmt.Fprintf(tw, "\ngeneral:\n  --validate-only\t\t%s\n", fs.Lookup("validate-only").Usage)
+		_ = tw.Flush()
 	}
-	return fallback
 }
 
-func envOrInt(key string, fallback int) int {
-	value := strings.TrimSpace(os.Getenv(key))
-	if value == "" {
-		return fallback
+// parseFlags parses args and rejects leftover positional arguments, so a
+// typo such as "validate-only" without dashes cannot start the relay.
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	return nil
+}
+
+func settingsInGroup(group string) []string {
+	var envs []string
+	for env, s := range settings {
+		if s.Group == group {
+			envs = append(envs, env)
+		}
+	}
+	slices.Sort(envs)
+	return envs
+}
+
+// newSettingSource captures the flags explicitly set on fs, so an explicit
+// empty flag still overrides the environment.
+func newSettingSource(fs *flag.FlagSet, getenv func(string) string) settingSource {
+	src := settingSource{flags: make(map[string]string), getenv: getenv}
+	fs.Visit(func(f *flag.Flag) {
+		for env := range settings {
+			if settingFlagName(env) == f.Name {
+				src.flags[env] = f.Value.String()
+			}
+		}
+	})
+	return src
+}
+
+// name returns how the setting was supplied, so errors point at the flag
+// the operator actually typed rather than the environment variable.
+func (src settingSource) name(env string) string {
+	if _, ok := src.flags[env]; ok {
+		return "--" + settingFlagName(env)
+	}
+	return env
+}
+
+func (src settingSource) string(env string) string {
+	if value, ok := src.flags[env]; ok {
+		return strings.TrimSpace(value)
 	}
+	if value := strings.TrimSpace(src.getenv(env)); value != "" {
+		return value
+	}
+	return settings[env].Default
+}
+
+// int parses an integer setting. A value that does not parse is recorded as
+// a problem and the default is returned so later checks don't repeat it.
+func (l *configLoader) int(env string) int {
+	fallback, _ := strconv.Atoi(settings[env].Default)
+	value := l.src.string(env)
 
 	parsed, err := strconv.Atoi(value)
 	if err != nil {
+		want := "an integer"
+		if strings.HasSuffix(env, "_MS") {
+			want = "an integer number of milliseconds"
+		}
+		l.problems = append(l.problems, fmt.Errorf("%s %q must be %s", l.src.name(env), value, want))
 		return fallback
 	}
 	return parsed
 }
 
-func envOrInts(key string, fallback []int) []int {
-	value := strings.TrimSpace(os.Getenv(key))
-	if value == "" {
+func (l *configLoader) ints(env string) []int {
+	fallback, _ := parseInts(settings[env].Default)
+	value := l.src.string(env)
+
+	values, err := parseInts(value)
+	if err != nil {
+		l.problems = append(l.problems, fmt.Errorf("%s %q must be a comma-separated list of integers", l.src.name(env), value))
 		return fallback
 	}
+	return values
+}
 
+// pubKey parses an optional hex pubkey; an empty value means none is set.
+func (l *configLoader) pubKey(env string) *nostr.PubKey {
+	value := l.src.string(env)
+	if value == "" {
+		return nil
+	}
+
+	pubKey, err := nostr.PubKeyFromHex(value)
+	if err != nil {
+		l.problems = append(l.problems, fmt.Errorf("%s must be a 64-character hex public key: %v", l.src.name(env), err))
+		return nil
+	}
+	return &pubKey
+}
+
+func parseInts(value string) ([]int, error) {
 	parts := strings.Split(value, ",")
 	values := make([]int, 0, len(parts))
 	for _, part := range parts {
@@ -309,13 +663,13 @@ func envOrInts(key string, fallback []int) []int {
 		}
 		parsed, err := strconv.Atoi(part)
 		if err != nil {
-			return fallback
+			return nil, err
 		}
 		values = append(values, parsed)
 	}
 
 	if len(values) == 0 {
-		return fallback
+		return nil, errors.New("empty list")
 	}
-	return values
+	return values, nil
 }
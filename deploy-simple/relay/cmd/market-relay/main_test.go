@@ -0,0 +1,193 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testSource(t *testing.T, args []string, env map[string]string) settingSource {
+	t.Helper()
+
+	fs := flag.NewFlagSet("market-relay", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Bool("validate-only", false, "")
+	registerSettingFlags(fs)
+	if err := parseFlags(fs, args); err != nil {
+		t.Fatalf("parse flags %v: %v", args, err)
+	}
+
+	return newSettingSource(fs, func(key string) string { return env[key] })
+}
+
+func TestParseFlagsRejectsPositionalArguments(t *testing.T) {
+	for _, args := range [][]string{{"validate-only"}, {"--validate-only", "extra"}} {
+		fs := flag.NewFlagSet("market-relay", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		fs.Bool("validate-only", false, "")
+		registerSettingFlags(fs)
+
+		if err := parseFlags(fs, args); err == nil {
+			t.Errorf("parseFlags(%q) accepted positional arguments", args)
+		}
+	}
+}
+
+func TestLoadConfigDefaults(t *testing.T) {
+	cfg := loadConfig(testSource(t, nil, nil))
+
+	if problems := cfg.validate(); len(problems) > 0 {
+		t.Fatalf("default config has problems: %v", problems)
+	}
+	if cfg.Name != "Plebeian Market Relay" || cfg.ListenAddr != "127.0.0.1:10547" || cfg.PubKey != nil {
+		t.Fatalf("unexpected defaults: %+v", cfg)
+	}
+	if cfg.MaxQueryLimit != 500 || !slices.Equal(cfg.SupportedNIPs, []int{1, 11, 50}) {
+		t.Fatalf("unexpected numeric defaults: %+v", cfg)
+	}
+	if cfg.ReadHeaderMs != 10*time.Second || cfg.ShutdownTimeout != 10*time.Second {
+		t.Fatalf("unexpected timeout defaults: %+v", cfg)
+	}
+}
+
+// TestLoadConfigReadsEverySetting guards against typos in setting keys: a
+// misspelled key would have no flag and no default.
+func TestLoadConfigReadsEverySetting(t *testing.T) {
+	read := make(map[string]bool)
+	fs := flag.NewFlagSet("market-relay", flag.ContinueOnError)
+	loadConfig(newSettingSource(fs, func(key string) string {
+		read[key] = true
+		return ""
+	}))
+
+	for key := range read {
+		if _, ok := settings[key]; !ok {
+			t.Errorf("loadConfig reads %s, which is not in settings", key)
+		}
+	}
+	for key := range settings {
+		if !read[key] {
+			t.Errorf("setting %s is never read by loadConfig", key)
+		}
+	}
+}
+
+func TestSettingPrecedence(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+		want string
+	}{
+		{name: "default", want: "Plebeian Market Relay"},
+		{name: "env over default", env: map[string]string{"RELAY_NAME": "env"}, want: "env"},
+		{name: "blank env uses default", env: map[string]string{"RELAY_NAME": "   "}, want: "Plebeian Market Relay"},
+		{name: "flag over env", args: []string{"--name=flag"}, env: map[string]string{"RELAY_NAME": "env"}, want: "flag"},
+		{name: "explicit empty flag over env", args: []string{"--name="}, env: map[string]string{"RELAY_NAME": "env"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testSource(t, tt.args, tt.env).string("RELAY_NAME"); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadConfigMalformedValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		env     map[string]string
+		problem string
+	}{
+		{name: "int env", env: map[string]string{"RELAY_MAX_QUERY_LIMIT": "abc"}, problem: `RELAY_MAX_QUERY_LIMIT "abc" must be an integer`},
+		{name: "int flag", args: []string{"--max-query-limit=lots"}, problem: `--max-query-limit "lots" must be an integer`},
+		{name: "empty int flag", args: []string{"--max-query-limit="}, problem: `--max-query-limit "" must be an integer`},
+		{name: "duration", env: map[string]string{"RELAY_READ_HEADER_TIMEOUT_MS": "10s"}, problem: `RELAY_READ_HEADER_TIMEOUT_MS "10s" must be an integer number of milliseconds`},
+		{name: "nip list", env: map[string]string{"RELAY_SUPPORTED_NIPS": "1,eleven"}, problem: `RELAY_SUPPORTED_NIPS "1,eleven" must be a comma-separated list of integers`},
+		{name: "empty nip list", args: []string{"--supported-nips=,,"}, problem: `--supported-nips ",," must be a comma-separated list of integers`},
+		{name: "pubkey", env: map[string]string{"RELAY_PUBKEY": "zz"}, problem: "RELAY_PUBKEY must be a 64-character hex public key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := loadConfig(testSource(t, tt.args, tt.env)).validate()
+			if len(problems) != 1 || !strings.Contains(problems[0].Error(), tt.problem) {
+				t.Fatalf("got problems %v, want one containing %q", problems, tt.problem)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		modify  func(*config)
+		problem string
+	}{
+		{name: "empty name", modify: func(c *config) { c.Name = "" }, problem: "RELAY_NAME must not be empty"},
+		{name: "public url scheme", modify: func(c *config) { c.PublicURL = "https://relay.example" }, problem: "must use the ws or wss scheme"},
+		{name: "public url host", modify: func(c *config) { c.PublicURL = "wss://" }, problem: "is missing a host"},
+		{name: "public url parse", modify: func(c *config) { c.PublicURL = "wss://%zz" }, problem: "is not a valid URL"},
+		{name: "listen addr", modify: func(c *config) { c.ListenAddr = "localhost" }, problem: "must be host:port"},
+		{name: "listen port", modify: func(c *config) { c.ListenAddr = "127.0.0.1:99999" }, problem: "has an invalid port"},
+		{name: "query limit", modify: func(c *config) { c.MaxQueryLimit = 0 }, problem: "RELAY_MAX_QUERY_LIMIT must be positive"},
+		{name: "nip", modify: func(c *config) { c.SupportedNIPs = []int{1, -11} }, problem: "invalid NIP -11"},
+		{name: "read header timeout", modify: func(c *config) { c.ReadHeaderMs = 0 }, problem: "RELAY_READ_HEADER_TIMEOUT_MS must be positive"},
+		{name: "shutdown timeout", modify: func(c *config) { c.ShutdownTimeout = -time.Second }, problem: "RELAY_SHUTDOWN_TIMEOUT_MS must be positive"},
+		{name: "log level", modify: func(c *config) { c.LogLevel = "INFO+2" }, problem: "RELAY_LOG_LEVEL"},
+		{name: "log format", modify: func(c *config) { c.LogFormat = "xml" }, problem: "RELAY_LOG_FORMAT"},
+		{name: "store dirs", modify: func(c *config) { c.SearchIndexDir = c.RawEventStore + "/" }, problem: "must be different directories"},
+		{name: "empty data dir", args: []string{"--data-dir="}, problem: "--data-dir must not be empty"},
+		{name: "empty raw db dir", args: []string{"--raw-db-dir="}, problem: "--raw-db-dir must not be empty"},
+		{name: "empty search index dir", modify: func(c *config) { c.SearchIndexDir = "" }, problem: "RELAY_SEARCH_INDEX_DIR must not be empty"},
+		{name: "public url flag", args: []string{"--public-url=http://a"}, problem: `--public-url "http://a" must use the ws or wss scheme`},
+		{name: "empty name flag", args: []string{"--name="}, problem: "--name must not be empty"},
+		{name: "store dirs flag", args: []string{"--search-index-dir=/var/lib/market-relay/raw"}, problem: "--search-index-dir and RELAY_RAW_DB_DIR must be different directories"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := loadConfig(testSource(t, tt.args, nil))
+			if tt.modify != nil {
+				tt.modify(&cfg)
+			}
+
+			problems := cfg.validate()
+			if len(problems) != 1 || !strings.Contains(problems[0].Error(), tt.problem) {
+				t.Fatalf("got problems %v, want one containing %q", problems, tt.problem)
+			}
+		})
+	}
+}
+
+func TestValidateReportsAllProblems(t *testing.T) {
+	src := testSource(t, nil, map[string]string{
+		"RELAY_MAX_QUERY_LIMIT": "0",
+		"RELAY_PUBLIC_URL":      "http://x",
+		"RELAY_SUPPORTED_NIPS":  "x",
+	})
+
+	if problems := loadConfig(src).validate(); len(problems) != 3 {
+		t.Fatalf("got %d problems, want 3: %v", len(problems), problems)
+	}
+}
+
+func TestParseLogLevel(t *testing.T) {
+	for _, value := range []string{"debug", "INFO", "Warn", "error"} {
+		if _, err := parseLogLevel(value); err != nil {
+			t.Errorf("parseLogLevel(%q): %v", value, err)
+		}
+	}
+	for _, value := range []string{"", "INFO+2", "warn-4", "trace"} {
+		if _, err := parseLogLevel(value); err == nil {
+			t.Errorf("parseLogLevel(%q) accepted an invalid level", value)
+		}
+	}
+}